@@ -1,26 +1,39 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	glog "github.com/golang/glog"
-	slack "github.com/nlopes/slack"
+	auditclientset "github.com/owainlewis/convoy/pkg/client/clientset/versioned"
+	auditinformers "github.com/owainlewis/convoy/pkg/client/informers/externalversions"
 	config "github.com/owainlewis/convoy/pkg/config"
 	controller "github.com/owainlewis/convoy/pkg/controller"
 	notifier "github.com/owainlewis/convoy/pkg/notifier"
+	predicate "github.com/owainlewis/convoy/pkg/predicate"
+	uuid "k8s.io/apimachinery/pkg/util/uuid"
 	informers "k8s.io/client-go/informers"
 	kubernetes "k8s.io/client-go/kubernetes"
 	rest "k8s.io/client-go/rest"
 	clientcmd "k8s.io/client-go/tools/clientcmd"
+	resourcelock "k8s.io/client-go/tools/leaderelection/resourcelock"
 )
 
 var conf = flag.String("config", "", "Path to config YAML")
 var kubeconfig = flag.String("kubeconfig", "", "Path to a kubeconfig file")
+var leaderElect = flag.Bool("leader-elect", false, "Enable leader election, for running multiple replicas in HA")
+var leaderElectionNamespace = flag.String("leader-election-namespace", "kube-system", "Namespace holding the leader election Lease")
 
 func main() {
 	flag.Parse()
 
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
 	glog.Info("Running controller")
 
 	client, err := buildClient(*kubeconfig)
@@ -29,28 +42,51 @@ func main() {
 		return
 	}
 
+	auditClient, err := buildAuditClient(*kubeconfig)
+	if err != nil {
+		glog.Errorf("Failed to build audit clientset: %s", err)
+		return
+	}
+
 	c, err := getConfig(*conf)
 	if err != nil {
 		glog.Fatalf("Failed to load configuration %s", err)
 	}
 
 	sharedInformers := informers.NewSharedInformerFactory(client, 10*time.Minute)
+	sharedAuditInformers := auditinformers.NewSharedInformerFactory(auditClient, 10*time.Minute)
+
+	registry, err := notifier.RegistryFromConfig(c)
+	if err != nil {
+		glog.Fatalf("Failed to build notifier sinks: %s", err)
+	}
 
-	slackClient := slack.New(c.Notifier.Slack.Token)
+	predicates, err := predicate.ChainFromConfig(c.Predicates)
+	if err != nil {
+		glog.Fatalf("Failed to build predicates: %s", err)
+	}
 
-	notifier := notifier.NewSlackNotifier(slackClient, "convoyk8s")
+	var leaderElection *controller.LeaderElection
+	if *leaderElect {
+		leaderElection, err = buildLeaderElection(client, *leaderElectionNamespace)
+		if err != nil {
+			glog.Fatalf("Failed to configure leader election: %s", err)
+		}
+	}
 
 	ctrl := controller.NewConvoyController(
+		ctx,
 		client,
 		sharedInformers.Core().V1().Events(),
-		notifier)
-
-	stopCh := make(chan struct{})
-
-	defer close(stopCh)
-
-	sharedInformers.Start(stopCh)
-	ctrl.Run(stopCh)
+		sharedAuditInformers.Audit().V1alpha1().AuditRules(),
+		registry,
+		*c,
+		leaderElection,
+		predicates)
+
+	sharedInformers.Start(ctx.Done())
+	sharedAuditInformers.Start(ctx.Done())
+	ctrl.Run(ctx)
 }
 
 // Build a Kubernetes client.
@@ -69,6 +105,47 @@ func buildClient(conf string) (*kubernetes.Clientset, error) {
 	return client, nil
 }
 
+// Build a clientset for the AuditRule CRD.
+func buildAuditClient(conf string) (*auditclientset.Clientset, error) {
+	config, err := getKubeConfig(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	return auditclientset.NewForConfig(config)
+}
+
+// buildLeaderElection constructs a Lease-based leader election lock in
+// namespace, identifying this replica by hostname plus a random suffix so
+// multiple replicas running on the same host remain distinguishable.
+func buildLeaderElection(client kubernetes.Interface, namespace string) (*controller.LeaderElection, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := resourcelock.New(
+		resourcelock.LeasesResourceLock,
+		namespace,
+		"convoy-controller",
+		client.CoreV1(),
+		client.CoordinationV1(),
+		resourcelock.ResourceLockConfig{
+			Identity: hostname + "_" + string(uuid.NewUUID()),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &controller.LeaderElection{
+		Lock:          lock,
+		LeaseDuration: 15 * time.Second,
+		RenewDeadline: 10 * time.Second,
+		RetryPeriod:   2 * time.Second,
+	}, nil
+}
+
 func getKubeConfig(kubeconfig string) (*rest.Config, error) {
 	if kubeconfig != "" {
 		return clientcmd.BuildConfigFromFlags("", kubeconfig)
@@ -0,0 +1,4 @@
+// Package v1alpha1 is the v1alpha1 version of the audit API.
+// +k8s:deepcopy-gen=package
+// +groupName=audit.convoy.io
+package v1alpha1
@@ -0,0 +1,71 @@
+package v1alpha1
+
+import (
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AuditRule describes which Kubernetes events should be dispatched, and where.
+type AuditRule struct {
+	meta_v1.TypeMeta   `json:",inline"`
+	meta_v1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec AuditRuleSpec `json:"spec"`
+}
+
+// AuditRuleSpec is the spec for an AuditRule resource
+type AuditRuleSpec struct {
+	// Selector describes which events this rule matches.
+	Selector AuditRuleSelector `json:"selector"`
+
+	// Sinks is the list of sinks an event is routed to when it matches Selector.
+	Sinks []SinkRef `json:"sinks"`
+}
+
+// AuditRuleSelector describes the set of events an AuditRule matches.
+type AuditRuleSelector struct {
+	// InvolvedObjectKind restricts matches to events whose InvolvedObject.Kind
+	// equals this value, e.g. "Pod". Empty matches any kind.
+	InvolvedObjectKind string `json:"involvedObjectKind,omitempty"`
+
+	// Namespaces restricts matches to events in one of these namespaces.
+	// Empty matches any namespace.
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// ReasonRegex is matched against event.Reason. Empty matches any reason.
+	ReasonRegex string `json:"reasonRegex,omitempty"`
+
+	// Type restricts matches to events of this Type, e.g. "Normal" or "Warning".
+	// Empty matches any type.
+	Type string `json:"type,omitempty"`
+
+	// MinCount is the minimum event.Count required for a match. Zero means no minimum.
+	MinCount int32 `json:"minCount,omitempty"`
+
+	// LabelSelector is matched against the labels of the involved object.
+	LabelSelector *meta_v1.LabelSelector `json:"labelSelector,omitempty"`
+}
+
+// SinkRef identifies a notifier sink that a matched event should be routed to.
+type SinkRef struct {
+	// Name identifies the sink, e.g. "slack" or "webhook".
+	Name string `json:"name"`
+
+	// Channel is the Slack channel to post to, when Name refers to a Slack sink.
+	Channel string `json:"channel,omitempty"`
+
+	// URL is the webhook endpoint to POST to, when Name refers to a webhook sink.
+	URL string `json:"url,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AuditRuleList is a list of AuditRule resources
+type AuditRuleList struct {
+	meta_v1.TypeMeta `json:",inline"`
+	meta_v1.ListMeta `json:"metadata"`
+
+	Items []AuditRule `json:"items"`
+}
@@ -0,0 +1,140 @@
+package config
+
+import (
+	"io/ioutil"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Config is the top level configuration for the convoy controller.
+type Config struct {
+	Sinks      []SinkConfig      `yaml:"sinks"`
+	Dedup      DedupConfig       `yaml:"dedup,omitempty"`
+	Predicates []PredicateConfig `yaml:"predicates,omitempty"`
+}
+
+// PredicateConfig configures one predicate in the chain evaluated against
+// every event before AuditRule matching and dedup. An event must satisfy
+// every set field to pass this predicate; unset fields are skipped. The
+// chain as a whole is built by ANDing every configured predicate together.
+type PredicateConfig struct {
+	// InvolvedObjectKind restricts matches to events whose InvolvedObject.Kind
+	// equals this value, e.g. "Pod".
+	InvolvedObjectKind string `yaml:"involvedObjectKind,omitempty"`
+
+	// Namespaces restricts matches to events in one of these namespaces.
+	Namespaces []string `yaml:"namespaces,omitempty"`
+
+	// Type restricts matches to events of this Type, e.g. "Normal" or "Warning".
+	Type string `yaml:"type,omitempty"`
+
+	// ReasonRegex is matched against event.Reason.
+	ReasonRegex string `yaml:"reasonRegex,omitempty"`
+
+	// MinCount is the minimum event.Count required for a match.
+	MinCount int32 `yaml:"minCount,omitempty"`
+
+	// AgeWindow matches events last observed within this long of now.
+	// Accepts a Go duration string, e.g. "5m".
+	AgeWindow string `yaml:"ageWindow,omitempty"`
+}
+
+// DedupConfig controls how the controller avoids re-dispatching the same
+// repeating event.
+type DedupConfig struct {
+	// Window bounds how long a namespace/name/reason's last-dispatched
+	// count is remembered. Once it expires, that reason firing again is
+	// treated as new rather than being deduplicated. Accepts a Go duration
+	// string, e.g. "10m". Defaults to 10 minutes when empty.
+	Window string `yaml:"window,omitempty"`
+
+	// DispatchHistoricalEvents, if true, disables the startup cutoff so
+	// events that already existed in the informer cache before the
+	// controller started are dispatched too. Defaults to false: only
+	// events observed after startup are ever dispatched.
+	DispatchHistoricalEvents bool `yaml:"dispatchHistoricalEvents,omitempty"`
+}
+
+// SinkConfig configures a single named notifier sink. Exactly one of the
+// type-specific fields (Slack, Webhook, PagerDuty, Teams, SMTP, File)
+// should be set, matching Type.
+type SinkConfig struct {
+	// Name identifies the sink, as referenced from an AuditRule's Spec.Sinks.
+	Name string `yaml:"name"`
+
+	// Type selects which kind of sink to build: slack, webhook, pagerduty,
+	// teams, smtp or file.
+	Type string `yaml:"type"`
+
+	// Subject and Body are Go templates executed against the dispatched
+	// *v1.Event. If empty, DefaultSubjectTemplate/DefaultBodyTemplate are used.
+	Subject string `yaml:"subject,omitempty"`
+	Body    string `yaml:"body,omitempty"`
+
+	RateLimit RateLimitConfig `yaml:"rateLimit,omitempty"`
+
+	Slack     *SlackSinkConfig     `yaml:"slack,omitempty"`
+	Webhook   *WebhookSinkConfig   `yaml:"webhook,omitempty"`
+	PagerDuty *PagerDutySinkConfig `yaml:"pagerDuty,omitempty"`
+	Teams     *TeamsSinkConfig     `yaml:"teams,omitempty"`
+	SMTP      *SMTPSinkConfig      `yaml:"smtp,omitempty"`
+	File      *FileSinkConfig      `yaml:"file,omitempty"`
+}
+
+// RateLimitConfig bounds how many events per second a sink accepts.
+type RateLimitConfig struct {
+	QPS   float64 `yaml:"qps,omitempty"`
+	Burst int     `yaml:"burst,omitempty"`
+}
+
+// SlackSinkConfig configures a Slack sink.
+type SlackSinkConfig struct {
+	Token   string `yaml:"token"`
+	Channel string `yaml:"channel"`
+}
+
+// WebhookSinkConfig configures a generic webhook sink.
+type WebhookSinkConfig struct {
+	URL string `yaml:"url"`
+}
+
+// PagerDutySinkConfig configures a PagerDuty Events API v2 sink.
+type PagerDutySinkConfig struct {
+	RoutingKey string `yaml:"routingKey"`
+}
+
+// TeamsSinkConfig configures a Microsoft Teams incoming-webhook sink.
+type TeamsSinkConfig struct {
+	WebhookURL string `yaml:"webhookURL"`
+}
+
+// SMTPSinkConfig configures an email sink.
+type SMTPSinkConfig struct {
+	Host     string   `yaml:"host"`
+	Port     int      `yaml:"port"`
+	Username string   `yaml:"username,omitempty"`
+	Password string   `yaml:"password,omitempty"`
+	From     string   `yaml:"from"`
+	To       []string `yaml:"to"`
+}
+
+// FileSinkConfig configures a JSONL file (or stdout) sink.
+type FileSinkConfig struct {
+	// Path is the file to append to. If empty, the sink writes to stdout.
+	Path string `yaml:"path,omitempty"`
+}
+
+// FromFile loads a Config from a YAML file at path.
+func FromFile(path string) (*Config, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Config{}
+	if err := yaml.Unmarshal(data, c); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
@@ -1,29 +1,45 @@
 package controller
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"time"
 
-	glog "github.com/golang/glog"
+	auditinformers "github.com/owainlewis/convoy/pkg/client/informers/externalversions/audit/v1alpha1"
+	auditlisters "github.com/owainlewis/convoy/pkg/client/listers/audit/v1alpha1"
 	config "github.com/owainlewis/convoy/pkg/config"
 	dispatch "github.com/owainlewis/convoy/pkg/dispatch"
+	predicate "github.com/owainlewis/convoy/pkg/predicate"
+	rate "golang.org/x/time/rate"
 	v1 "k8s.io/api/core/v1"
 	errors "k8s.io/apimachinery/pkg/api/errors"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	runtime "k8s.io/apimachinery/pkg/util/runtime"
 	wait "k8s.io/apimachinery/pkg/util/wait"
 	informercorev1 "k8s.io/client-go/informers/core/v1"
 	kubernetes "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
 	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	listerv1 "k8s.io/client-go/listers/core/v1"
 	cache "k8s.io/client-go/tools/cache"
+	leaderelection "k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/record"
 	workqueue "k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
 )
 
 const (
 	// ConvoyEventType defines the type of event to watch
 	ConvoyEventType = "Pod"
+
+	// controllerAgentName is the component name under which the controller
+	// records Events about objects it dispatches on behalf of.
+	controllerAgentName = "convoy"
+
+	// FieldManager identifies convoy as the field owner for any writes it
+	// makes via server-side apply.
+	FieldManager = "convoy-controller"
 )
 
 // ConvoyController defines the structure of the controller
@@ -32,27 +48,88 @@ type ConvoyController struct {
 	eventGetter       corev1.EventsGetter
 	eventLister       listerv1.EventLister
 	eventListerSynced cache.InformerSynced
-	queue             workqueue.RateLimitingInterface
-	dispatch          dispatch.Notifier
+	ruleLister        auditlisters.AuditRuleLister
+	ruleListerSynced  cache.InformerSynced
+	rules             *ruleCache
+	queue             workqueue.TypedRateLimitingInterface[cache.ObjectName]
+	registry          *dispatch.Registry
+	recorder          record.EventRecorder
 	config            config.Config
+	dedup             *dedupCache
+	predicates        []predicate.Predicate
+	startedAt         time.Time
+	leaderElection    *LeaderElection
+}
+
+// newQueueRateLimiter builds the exponential-backoff-or-bucket rate limiter
+// used for the controller's workqueue, matching the modern sample-controller
+// pattern: backoff starts at 5ms and grows exponentially up to 1000s per
+// item, with an overall ceiling of 50 qps and bursts of up to 300 items.
+func newQueueRateLimiter() workqueue.TypedRateLimiter[cache.ObjectName] {
+	return workqueue.NewTypedMaxOfRateLimiter(
+		workqueue.NewTypedItemExponentialFailureRateLimiter[cache.ObjectName](5*time.Millisecond, 1000*time.Second),
+		&workqueue.TypedBucketRateLimiter[cache.ObjectName]{Limiter: rate.NewLimiter(rate.Limit(50), 300)},
+	)
+}
+
+// defaultDedupWindow is used when config.Dedup.Window is unset or invalid.
+const defaultDedupWindow = 10 * time.Minute
+
+func dedupWindowFromConfig(ctx context.Context, cfg config.Config) time.Duration {
+	if cfg.Dedup.Window == "" {
+		return defaultDedupWindow
+	}
+
+	window, err := time.ParseDuration(cfg.Dedup.Window)
+	if err != nil {
+		klog.FromContext(ctx).Error(err, "Invalid dedup.window, using default", "window", cfg.Dedup.Window, "default", defaultDedupWindow)
+		return defaultDedupWindow
+	}
+
+	return window
 }
 
-// NewConvoyController creates a new Convoy controller
+// NewConvoyController creates a new Convoy controller. leaderElection may be
+// nil, in which case the controller starts workers immediately without
+// contesting leadership. predicates is an optional chain evaluated against
+// every event before AuditRule matching and dedup; an event must satisfy
+// every predicate to be considered for dispatch, and a nil or empty chain
+// matches everything.
 func NewConvoyController(
+	ctx context.Context,
 	client kubernetes.Interface,
 	informer informercorev1.EventInformer,
-	dispatch dispatch.Notifier,
-	config config.Config) *ConvoyController {
+	ruleInformer auditinformers.AuditRuleInformer,
+	registry *dispatch.Registry,
+	config config.Config,
+	leaderElection *LeaderElection,
+	predicates []predicate.Predicate) *ConvoyController {
+	logger := klog.FromContext(ctx)
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartStructuredLogging(0)
+	eventBroadcaster.StartRecordingToSink(&corev1.EventSinkImpl{Interface: client.CoreV1().Events("")})
+	recorder := eventBroadcaster.NewRecorder(scheme.Scheme, v1.EventSource{Component: controllerAgentName})
+
 	c := &ConvoyController{
 		client:            client,
 		eventGetter:       client.CoreV1(),
 		eventLister:       informer.Lister(),
 		eventListerSynced: informer.Informer().HasSynced,
-		queue:             workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
-		dispatch:          dispatch,
+		ruleLister:        ruleInformer.Lister(),
+		ruleListerSynced:  ruleInformer.Informer().HasSynced,
+		rules:             newRuleCache(),
+		queue:             workqueue.NewTypedRateLimitingQueue[cache.ObjectName](newQueueRateLimiter()),
+		registry:          registry,
+		recorder:          recorder,
 		config:            config,
+		dedup:             newDedupCache(dedupWindowFromConfig(ctx, config)),
+		predicates:        predicates,
+		leaderElection:    leaderElection,
 	}
 
+	logger.V(4).Info("Setting up event handlers")
+
 	informer.Informer().AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
@@ -61,86 +138,127 @@ func NewConvoyController(
 		},
 	)
 
+	ruleInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    func(obj interface{}) { c.refreshRules(ctx) },
+			UpdateFunc: func(old, new interface{}) { c.refreshRules(ctx) },
+			DeleteFunc: func(obj interface{}) { c.refreshRules(ctx) },
+		},
+	)
+
 	return c
 }
 
-// Run will start the controller
-func (c *ConvoyController) Run(stopCh chan struct{}) {
+// refreshRules recompiles the rule cache from the current contents of the
+// AuditRule lister. It is called whenever the AuditRule informer observes
+// a change, so rule updates take effect without a controller restart.
+func (c *ConvoyController) refreshRules(ctx context.Context) {
+	rules, err := c.ruleLister.List(labels.Everything())
+	if err != nil {
+		runtime.HandleError(fmt.Errorf("failed to list AuditRules: %s", err))
+		return
+	}
+	c.rules.set(ctx, rules)
+}
+
+// Run will start the controller. If leaderElection is configured, this
+// replica contests the lease and only starts workers for as long as it
+// holds leadership; other replicas keep their informer caches warm (synced
+// above, before leadership is even contested) while they wait to acquire
+// it. With no leaderElection configured, workers start immediately, which
+// is the right behaviour for a single-replica deployment.
+func (c *ConvoyController) Run(ctx context.Context) {
+	defer runtime.HandleCrash()
 	defer c.queue.ShutDown()
 
-	glog.Info("Waiting for cache sync")
-	if !cache.WaitForCacheSync(stopCh, c.eventListerSynced) {
-		glog.Info("Timeout waiting for caches to sync")
+	logger := klog.FromContext(ctx)
+	c.startedAt = time.Now()
+
+	logger.Info("Waiting for caches to sync")
+	if !cache.WaitForCacheSync(ctx.Done(), c.eventListerSynced, c.ruleListerSynced) {
+		logger.Info("Timeout waiting for caches to sync")
 		return
 	}
-	log.Print("Caches are synced")
+	logger.Info("Caches are synced")
+
+	c.refreshRules(ctx)
 
-	go wait.Until(c.runWorker, time.Second, stopCh)
+	if c.leaderElection == nil {
+		go wait.UntilWithContext(ctx, c.runWorker, time.Second)
+		<-ctx.Done()
+		logger.Info("Stopping controller")
+		return
+	}
+
+	logger.Info("Waiting to acquire leadership")
+	leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+		Lock:          c.leaderElection.Lock,
+		LeaseDuration: c.leaderElection.LeaseDuration,
+		RenewDeadline: c.leaderElection.RenewDeadline,
+		RetryPeriod:   c.leaderElection.RetryPeriod,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				logger.Info("Acquired leadership, starting workers")
+				go wait.UntilWithContext(ctx, c.runWorker, time.Second)
+			},
+			OnStoppedLeading: func() {
+				logger.Info("Lost leadership, stopping workers")
+			},
+		},
+	})
 
-	<-stopCh
-	glog.Info("Stopping controller")
+	logger.Info("Stopping controller")
 }
 
 func (c *ConvoyController) enqueue(obj interface{}) {
-	var key string
-	var err error
-
-	if key, err = cache.MetaNamespaceKeyFunc(obj); err != nil {
+	objectName, err := cache.ObjectToName(obj)
+	if err != nil {
 		runtime.HandleError(err)
 		return
 	}
 
-	c.queue.AddRateLimited(key)
+	c.queue.AddRateLimited(objectName)
 	eventsQueued.Inc()
 }
 
-func (c *ConvoyController) runWorker() {
-	for c.processNextWorkItem() {
+func (c *ConvoyController) runWorker(ctx context.Context) {
+	for c.processNextWorkItem(ctx) {
 	}
 }
 
-func (c *ConvoyController) processNextWorkItem() bool {
-	obj, shutdown := c.queue.Get()
+func (c *ConvoyController) processNextWorkItem(ctx context.Context) bool {
+	logger := klog.FromContext(ctx)
+
+	objectName, shutdown := c.queue.Get()
 
 	if shutdown {
 		return false
 	}
 
-	// We wrap this block in a func so we can defer c.workqueue.Done.
-	err := func(obj interface{}) error {
+	// We wrap this block in a func so we can defer c.queue.Done.
+	err := func(objectName cache.ObjectName) error {
 		// We call Done here so the workqueue knows we have finished
 		// processing this item. We also must remember to call Forget if we
 		// do not want this work item being re-queued. For example, we do
 		// not call Forget if a transient error occurs, instead the item is
 		// put back on the workqueue and attempted again after a back-off
 		// period.
-		defer c.queue.Done(obj)
-		var key string
-		var ok bool
-		// We expect strings to come off the workqueue. These are of the
-		// form namespace/name. We do this as the delayed nature of the
-		// workqueue means the items in the informer cache may actually be
-		// more up to date that when the item was initially put onto the
-		// workqueue.
-		if key, ok = obj.(string); !ok {
-			// As the item in the workqueue is actually invalid, we call
-			// Forget here else we'd go into a loop of attempting to
-			// process a work item that is invalid.
-			c.queue.Forget(obj)
-			runtime.HandleError(fmt.Errorf("expected string in workqueue but got %#v", obj))
-			return nil
-		}
-		// Run the syncHandler, passing it the namespace/name string of the
-		// Foo resource to be synced.
-		if err := c.syncHandler(key); err != nil {
-			return fmt.Errorf("error syncing '%s': %s", key, err.Error())
+		defer c.queue.Done(objectName)
+
+		// Run the syncHandler, passing it the namespace/name of the Event
+		// to be synced.
+		if err := c.syncHandler(ctx, objectName); err != nil {
+			c.queue.AddRateLimited(objectName)
+			return fmt.Errorf("error syncing '%s': %s", objectName, err.Error())
 		}
+
 		// Finally, if no error occurs we Forget this item so it does not
 		// get queued again until another change happens.
-		c.queue.Forget(obj)
+		c.queue.Forget(objectName)
+		logger.V(4).Info("Successfully synced", "objectName", objectName)
 
 		return nil
-	}(obj)
+	}(objectName)
 
 	if err != nil {
 		runtime.HandleError(err)
@@ -150,47 +268,136 @@ func (c *ConvoyController) processNextWorkItem() bool {
 	return true
 }
 
-func (c *ConvoyController) syncHandler(key string) error {
-	// Convert the namespace/name string into a distinct namespace and name
-	namespace, name, err := cache.SplitMetaNamespaceKey(key)
-	if err != nil {
-		runtime.HandleError(fmt.Errorf("invalid resource key: %s", key))
-		return nil
-	}
-
-	event, err := c.eventLister.Events(namespace).Get(name)
+func (c *ConvoyController) syncHandler(ctx context.Context, objectName cache.ObjectName) error {
+	event, err := c.eventLister.Events(objectName.Namespace).Get(objectName.Name)
 	if err != nil {
 		if errors.IsNotFound(err) {
-			runtime.HandleError(fmt.Errorf("foo '%s' in work queue no longer exists", key))
+			runtime.HandleError(fmt.Errorf("event '%s' in work queue no longer exists", objectName))
 			return nil
 		}
 
 		return err
 	}
 
-	c.processEvent(event)
+	c.processEvent(ctx, event)
 
 	return nil
 }
 
-// TODO apply filters here (if event.InvolvedObject.Kind == ConvoyEventType)
-func (c *ConvoyController) processEvent(event *v1.Event) {
+func (c *ConvoyController) processEvent(ctx context.Context, event *v1.Event) {
+	logger := klog.FromContext(ctx).WithValues("namespace", event.Namespace, "name", event.Name, "reason", event.Reason)
+
 	// We want to ensure that only new events are dispatched
 	// else we'll end up spamming the dispatchs with old events
-	if !c.isStale(event) {
-		eventsProcessed.Inc()
-		err := c.dispatch.Dispatch(event)
-		if err != nil {
-			glog.Errorf("Failed to dispatch message: %s", err)
+	if c.isStale(event) {
+		return
+	}
+
+	if !c.predicatesMatch(event) {
+		return
+	}
+
+	// Dedup repeat firings of the same namespace/name/reason: only
+	// dispatch again once Count has grown past what we last dispatched,
+	// or once the previous dispatch has aged out of the dedup window.
+	if !c.dedup.allow(event, time.Now()) {
+		return
+	}
+
+	targets := c.matchedSinkTargets(ctx, event)
+	if len(targets) == 0 {
+		return
+	}
+
+	eventsProcessed.Inc()
+
+	for _, result := range c.registry.Dispatch(ctx, targets, event) {
+		sinkLogger := logger.WithValues("sink", result.Sink)
+		if result.Err != nil {
+			sinkLogger.Error(result.Err, "Failed to dispatch to sink")
+			sinkDispatchTotal.WithLabelValues(result.Sink, "failure").Inc()
+			c.recorder.Eventf(&event.InvolvedObject, v1.EventTypeWarning, "DispatchFailed", "Failed to dispatch to sink %q: %s", result.Sink, result.Err)
+			continue
+		}
+		sinkLogger.V(4).Info("Dispatched event to sink")
+		sinkDispatchTotal.WithLabelValues(result.Sink, "success").Inc()
+		c.recorder.Eventf(&event.InvolvedObject, v1.EventTypeNormal, "Dispatched", "Dispatched to sink %q", result.Sink)
+	}
+}
+
+// predicatesMatch reports whether event satisfies every predicate in the
+// configured chain. A nil or empty chain matches everything.
+func (c *ConvoyController) predicatesMatch(event *v1.Event) bool {
+	for _, p := range c.predicates {
+		if !p.Match(event) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchedSinkTargets returns the sinks event should be routed to, carrying
+// any per-rule Channel/URL override from the matched AuditRule's SinkRef.
+// When no AuditRules are loaded we fall back to the previous behaviour of
+// dispatching to every registered sink with no override, so existing
+// deployments keep working until they define their first rule.
+func (c *ConvoyController) matchedSinkTargets(ctx context.Context, event *v1.Event) []dispatch.Target {
+	if c.rules.empty() {
+		names := c.registry.Names()
+		targets := make([]dispatch.Target, len(names))
+		for i, name := range names {
+			targets[i] = dispatch.Target{Name: name}
 		}
+		return targets
 	}
+
+	refs := c.rules.matchSinks(event, c.objectLabels(ctx, event))
+	targets := make([]dispatch.Target, len(refs))
+	for i, ref := range refs {
+		targets[i] = dispatch.Target{
+			Name: ref.Name,
+			Override: dispatch.Override{
+				Channel: ref.Channel,
+				URL:     ref.URL,
+			},
+		}
+	}
+	return targets
 }
 
+// objectLabels resolves the label set of event.InvolvedObject, for use by
+// AuditRule label selectors. Only Pods are resolved; for other kinds, or
+// when the Pod lookup fails, it returns an empty set, so a rule whose label
+// selector requires any label does not match those events.
+func (c *ConvoyController) objectLabels(ctx context.Context, event *v1.Event) labels.Set {
+	obj := event.InvolvedObject
+	if obj.Kind != ConvoyEventType {
+		return nil
+	}
+
+	pod, err := c.client.CoreV1().Pods(obj.Namespace).Get(ctx, obj.Name, meta_v1.GetOptions{})
+	if err != nil {
+		klog.FromContext(ctx).Error(err, "Failed to get Pod for label lookup", "namespace", obj.Namespace, "name", obj.Name)
+		return nil
+	}
+
+	return pod.Labels
+}
+
+// isStale reports whether event predates controller startup. Such events
+// were already sitting in the informer cache when we began watching and
+// are not new firings, so by default they are never dispatched. Honors
+// LastTimestamp over CreationTimestamp, since that's what actually advances
+// as an event repeats.
 func (c *ConvoyController) isStale(event *v1.Event) bool {
-	eventCreated := event.CreationTimestamp
-	now := meta_v1.Now()
-	if eventCreated.Unix() < now.Unix() {
-		return true
+	if c.config.Dedup.DispatchHistoricalEvents {
+		return false
 	}
-	return false
+
+	observed := event.LastTimestamp
+	if observed.IsZero() {
+		observed = event.CreationTimestamp
+	}
+
+	return observed.Time.Before(c.startedAt)
 }
@@ -0,0 +1,68 @@
+package controller
+
+import (
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// dedupEntry is the last event.Count we dispatched for a given event key,
+// and when we dispatched it.
+type dedupEntry struct {
+	count      int32
+	dispatched time.Time
+}
+
+// dedupCache suppresses repeat dispatches of the same firing event within a
+// TTL window, keyed by namespace/name/reason. A repeat firing is only let
+// through again once event.Count has grown past what we last dispatched,
+// or once the previous entry has aged out of the window.
+type dedupCache struct {
+	mu      sync.Mutex
+	window  time.Duration
+	entries map[string]dedupEntry
+}
+
+func newDedupCache(window time.Duration) *dedupCache {
+	return &dedupCache{
+		window:  window,
+		entries: make(map[string]dedupEntry),
+	}
+}
+
+func dedupKey(event *v1.Event) string {
+	return event.Namespace + "/" + event.InvolvedObject.Name + "/" + event.Reason
+}
+
+// allow reports whether event should be dispatched, and records it as the
+// most recently dispatched firing for its key if so.
+func (d *dedupCache) allow(event *v1.Event, now time.Time) bool {
+	key := dedupKey(event)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.sweep(now, key)
+
+	entry, ok := d.entries[key]
+	if ok && now.Sub(entry.dispatched) < d.window && event.Count <= entry.count {
+		return false
+	}
+
+	d.entries[key] = dedupEntry{count: event.Count, dispatched: now}
+	return true
+}
+
+// sweep opportunistically evicts entries that have aged out of the dedup
+// window, other than keep, which allow is about to read or overwrite. This
+// keeps entries from a long-running controller's map bounded to the set of
+// namespace/name/reason triples that have actually fired within the last
+// window, rather than growing for as long as the process runs.
+func (d *dedupCache) sweep(now time.Time, keep string) {
+	for key, entry := range d.entries {
+		if key != keep && now.Sub(entry.dispatched) >= d.window {
+			delete(d.entries, key)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newTestEvent(namespace, name, reason string, count int32, lastTimestamp time.Time) *v1.Event {
+	return &v1.Event{
+		ObjectMeta: meta_v1.ObjectMeta{Namespace: namespace, Name: name},
+		InvolvedObject: v1.ObjectReference{
+			Kind:      "Pod",
+			Namespace: namespace,
+			Name:      name,
+		},
+		Reason:        reason,
+		Count:         count,
+		LastTimestamp: meta_v1.NewTime(lastTimestamp),
+	}
+}
+
+func TestDedupCacheAllowsFirstFiring(t *testing.T) {
+	d := newDedupCache(10 * time.Minute)
+	now := time.Unix(1000, 0)
+
+	event := newTestEvent("default", "web-1", "BackOff", 1, now)
+	if !d.allow(event, now) {
+		t.Fatal("expected first firing of a new key to be allowed")
+	}
+}
+
+func TestDedupCacheSuppressesSameCountWithinWindow(t *testing.T) {
+	d := newDedupCache(10 * time.Minute)
+	now := time.Unix(1000, 0)
+
+	event := newTestEvent("default", "web-1", "BackOff", 3, now)
+	if !d.allow(event, now) {
+		t.Fatal("expected first firing to be allowed")
+	}
+
+	repeat := newTestEvent("default", "web-1", "BackOff", 3, now.Add(time.Minute))
+	if d.allow(repeat, now.Add(time.Minute)) {
+		t.Fatal("expected repeat firing with unchanged count inside the window to be suppressed")
+	}
+}
+
+func TestDedupCacheAllowsCountIncrement(t *testing.T) {
+	d := newDedupCache(10 * time.Minute)
+	now := time.Unix(1000, 0)
+
+	first := newTestEvent("default", "web-1", "BackOff", 3, now)
+	d.allow(first, now)
+
+	incremented := newTestEvent("default", "web-1", "BackOff", 4, now.Add(time.Minute))
+	if !d.allow(incremented, now.Add(time.Minute)) {
+		t.Fatal("expected a higher event.Count to be let through even inside the window")
+	}
+}
+
+func TestDedupCacheExpiresAfterWindow(t *testing.T) {
+	window := 10 * time.Minute
+	d := newDedupCache(window)
+	now := time.Unix(1000, 0)
+
+	first := newTestEvent("default", "web-1", "BackOff", 3, now)
+	d.allow(first, now)
+
+	afterWindow := now.Add(window)
+	repeat := newTestEvent("default", "web-1", "BackOff", 3, afterWindow)
+	if !d.allow(repeat, afterWindow) {
+		t.Fatal("expected the same count to be allowed again once the dedup window has elapsed")
+	}
+}
+
+func TestDedupCacheKeysByNamespaceNameReason(t *testing.T) {
+	d := newDedupCache(10 * time.Minute)
+	now := time.Unix(1000, 0)
+
+	d.allow(newTestEvent("default", "web-1", "BackOff", 3, now), now)
+
+	otherReason := newTestEvent("default", "web-1", "Failed", 3, now)
+	if !d.allow(otherReason, now) {
+		t.Fatal("expected a different reason for the same object to be allowed")
+	}
+
+	otherNamespace := newTestEvent("other", "web-1", "BackOff", 3, now)
+	if !d.allow(otherNamespace, now) {
+		t.Fatal("expected the same name/reason in a different namespace to be allowed")
+	}
+}
+
+func TestIsStaleIgnoresEventsBeforeStartup(t *testing.T) {
+	startedAt := time.Unix(1000, 0)
+	c := &ConvoyController{startedAt: startedAt}
+
+	before := newTestEvent("default", "web-1", "BackOff", 1, startedAt.Add(-time.Second))
+	if !c.isStale(before) {
+		t.Fatal("expected an event last observed before startup to be stale")
+	}
+
+	after := newTestEvent("default", "web-1", "BackOff", 1, startedAt.Add(time.Second))
+	if c.isStale(after) {
+		t.Fatal("expected an event last observed after startup to not be stale")
+	}
+}
+
+func TestIsStaleDispatchHistoricalEventsOverride(t *testing.T) {
+	startedAt := time.Unix(1000, 0)
+	c := &ConvoyController{startedAt: startedAt}
+	c.config.Dedup.DispatchHistoricalEvents = true
+
+	before := newTestEvent("default", "web-1", "BackOff", 1, startedAt.Add(-time.Second))
+	if c.isStale(before) {
+		t.Fatal("expected DispatchHistoricalEvents to disable the startup cutoff")
+	}
+}
@@ -0,0 +1,21 @@
+package controller
+
+import (
+	"time"
+
+	resourcelock "k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+// LeaderElection configures leader-election for HA deployments of the
+// controller. When nil is passed to NewConvoyController, the controller
+// starts its workers immediately without contesting leadership, which is
+// appropriate for single-replica deployments.
+type LeaderElection struct {
+	// Lock is the resource (typically a Lease) used to coordinate which
+	// replica is the leader.
+	Lock resourcelock.Interface
+
+	LeaseDuration time.Duration
+	RenewDeadline time.Duration
+	RetryPeriod   time.Duration
+}
@@ -0,0 +1,29 @@
+package controller
+
+import (
+	prometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	eventsQueued = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "convoy",
+		Name:      "events_queued_total",
+		Help:      "Total number of events added to the controller workqueue.",
+	})
+
+	eventsProcessed = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "convoy",
+		Name:      "events_processed_total",
+		Help:      "Total number of events dispatched to notifier sinks.",
+	})
+
+	sinkDispatchTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "convoy",
+		Name:      "sink_dispatch_total",
+		Help:      "Total number of per-sink dispatch attempts, by sink name and result.",
+	}, []string{"sink", "result"})
+)
+
+func init() {
+	prometheus.MustRegister(eventsQueued, eventsProcessed, sinkDispatchTotal)
+}
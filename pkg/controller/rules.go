@@ -0,0 +1,159 @@
+package controller
+
+import (
+	"context"
+	"regexp"
+	"sync"
+
+	auditv1alpha1 "github.com/owainlewis/convoy/pkg/apis/audit/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+)
+
+// compiledRule is an AuditRule with its selector pre-parsed so that
+// matching an event against it does not repeat that work on every event.
+type compiledRule struct {
+	name          string
+	kind          string
+	namespaces    map[string]bool
+	reason        *regexp.Regexp
+	eventType     string
+	minCount      int32
+	labelSelector labels.Selector
+	sinks         []auditv1alpha1.SinkRef
+}
+
+// ruleCache holds the set of compiled AuditRules currently known to the
+// controller. It is rebuilt wholesale whenever the AuditRule informer
+// observes a change, and read concurrently by worker goroutines.
+type ruleCache struct {
+	mu    sync.RWMutex
+	rules []*compiledRule
+}
+
+func newRuleCache() *ruleCache {
+	return &ruleCache{}
+}
+
+// set replaces the cache with a freshly compiled view of rules. Rules that
+// fail to compile (bad regex, bad label selector) are dropped with a
+// logged error rather than failing the whole refresh.
+func (rc *ruleCache) set(ctx context.Context, rules []*auditv1alpha1.AuditRule) {
+	logger := klog.FromContext(ctx)
+	compiled := make([]*compiledRule, 0, len(rules))
+
+	for _, rule := range rules {
+		cr, err := compileRule(rule)
+		if err != nil {
+			logger.Error(err, "Skipping AuditRule", "namespace", rule.Namespace, "name", rule.Name)
+			continue
+		}
+		compiled = append(compiled, cr)
+	}
+
+	rc.mu.Lock()
+	rc.rules = compiled
+	rc.mu.Unlock()
+}
+
+func compileRule(rule *auditv1alpha1.AuditRule) (*compiledRule, error) {
+	selector := rule.Spec.Selector
+
+	cr := &compiledRule{
+		name:      rule.Namespace + "/" + rule.Name,
+		kind:      selector.InvolvedObjectKind,
+		eventType: selector.Type,
+		minCount:  selector.MinCount,
+		sinks:     rule.Spec.Sinks,
+	}
+
+	if len(selector.Namespaces) > 0 {
+		cr.namespaces = make(map[string]bool, len(selector.Namespaces))
+		for _, ns := range selector.Namespaces {
+			cr.namespaces[ns] = true
+		}
+	}
+
+	if selector.ReasonRegex != "" {
+		re, err := regexp.Compile(selector.ReasonRegex)
+		if err != nil {
+			return nil, err
+		}
+		cr.reason = re
+	}
+
+	if selector.LabelSelector != nil {
+		sel, err := meta_v1.LabelSelectorAsSelector(selector.LabelSelector)
+		if err != nil {
+			return nil, err
+		}
+		cr.labelSelector = sel
+	}
+
+	return cr, nil
+}
+
+// match reports whether event satisfies every field of the rule's selector.
+// objectLabels is the label set of event.InvolvedObject, resolved by the
+// caller, and may be nil if it could not be resolved.
+func (cr *compiledRule) match(event *v1.Event, objectLabels labels.Set) bool {
+	if cr.kind != "" && cr.kind != event.InvolvedObject.Kind {
+		return false
+	}
+
+	if cr.namespaces != nil && !cr.namespaces[event.Namespace] {
+		return false
+	}
+
+	if cr.reason != nil && !cr.reason.MatchString(event.Reason) {
+		return false
+	}
+
+	if cr.eventType != "" && cr.eventType != event.Type {
+		return false
+	}
+
+	if cr.minCount > 0 && event.Count < cr.minCount {
+		return false
+	}
+
+	if cr.labelSelector != nil && !cr.labelSelector.Matches(objectLabels) {
+		return false
+	}
+
+	return true
+}
+
+// matchSinks returns the union of sinks referenced by every rule that
+// matches event, deduplicated by sink name.
+func (rc *ruleCache) matchSinks(event *v1.Event, objectLabels labels.Set) []auditv1alpha1.SinkRef {
+	rc.mu.RLock()
+	defer rc.mu.RUnlock()
+
+	seen := make(map[string]bool)
+	var sinks []auditv1alpha1.SinkRef
+
+	for _, rule := range rc.rules {
+		if !rule.match(event, objectLabels) {
+			continue
+		}
+		for _, sink := range rule.sinks {
+			if seen[sink.Name] {
+				continue
+			}
+			seen[sink.Name] = true
+			sinks = append(sinks, sink)
+		}
+	}
+
+	return sinks
+}
+
+// empty reports whether no AuditRules are currently loaded.
+func (rc *ruleCache) empty() bool {
+	rc.mu.RLock()
+	defer rc.mu.RUnlock()
+	return len(rc.rules) == 0
+}
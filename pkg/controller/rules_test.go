@@ -0,0 +1,137 @@
+package controller
+
+import (
+	"testing"
+
+	auditv1alpha1 "github.com/owainlewis/convoy/pkg/apis/audit/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func newTestRule(selector auditv1alpha1.AuditRuleSelector) *auditv1alpha1.AuditRule {
+	return &auditv1alpha1.AuditRule{
+		ObjectMeta: meta_v1.ObjectMeta{Namespace: "default", Name: "rule"},
+		Spec: auditv1alpha1.AuditRuleSpec{
+			Selector: selector,
+			Sinks:    []auditv1alpha1.SinkRef{{Name: "slack"}},
+		},
+	}
+}
+
+func compileTestRule(t *testing.T, selector auditv1alpha1.AuditRuleSelector) *compiledRule {
+	t.Helper()
+
+	cr, err := compileRule(newTestRule(selector))
+	if err != nil {
+		t.Fatalf("unexpected error compiling rule: %s", err)
+	}
+	return cr
+}
+
+func TestCompiledRuleMatchInvolvedObjectKind(t *testing.T) {
+	cr := compileTestRule(t, auditv1alpha1.AuditRuleSelector{InvolvedObjectKind: "Pod"})
+
+	match := &v1.Event{InvolvedObject: v1.ObjectReference{Kind: "Pod"}}
+	if !cr.match(match, nil) {
+		t.Fatal("expected event with matching InvolvedObject.Kind to match")
+	}
+
+	noMatch := &v1.Event{InvolvedObject: v1.ObjectReference{Kind: "Deployment"}}
+	if cr.match(noMatch, nil) {
+		t.Fatal("expected event with different InvolvedObject.Kind not to match")
+	}
+}
+
+func TestCompiledRuleMatchNamespaces(t *testing.T) {
+	cr := compileTestRule(t, auditv1alpha1.AuditRuleSelector{Namespaces: []string{"prod"}})
+
+	match := &v1.Event{ObjectMeta: meta_v1.ObjectMeta{Namespace: "prod"}}
+	if !cr.match(match, nil) {
+		t.Fatal("expected event in a listed namespace to match")
+	}
+
+	noMatch := &v1.Event{ObjectMeta: meta_v1.ObjectMeta{Namespace: "staging"}}
+	if cr.match(noMatch, nil) {
+		t.Fatal("expected event in an unlisted namespace not to match")
+	}
+}
+
+func TestCompiledRuleMatchReasonRegex(t *testing.T) {
+	cr := compileTestRule(t, auditv1alpha1.AuditRuleSelector{ReasonRegex: "^Back.*"})
+
+	match := &v1.Event{Reason: "BackOff"}
+	if !cr.match(match, nil) {
+		t.Fatal("expected event with a matching Reason to match")
+	}
+
+	noMatch := &v1.Event{Reason: "Pulled"}
+	if cr.match(noMatch, nil) {
+		t.Fatal("expected event with a non-matching Reason not to match")
+	}
+}
+
+func TestCompiledRuleMatchType(t *testing.T) {
+	cr := compileTestRule(t, auditv1alpha1.AuditRuleSelector{Type: v1.EventTypeWarning})
+
+	match := &v1.Event{Type: v1.EventTypeWarning}
+	if !cr.match(match, nil) {
+		t.Fatal("expected event with a matching Type to match")
+	}
+
+	noMatch := &v1.Event{Type: v1.EventTypeNormal}
+	if cr.match(noMatch, nil) {
+		t.Fatal("expected event with a different Type not to match")
+	}
+}
+
+func TestCompiledRuleMatchMinCount(t *testing.T) {
+	cr := compileTestRule(t, auditv1alpha1.AuditRuleSelector{MinCount: 3})
+
+	if cr.match(&v1.Event{Count: 2}, nil) {
+		t.Fatal("expected event with Count below MinCount not to match")
+	}
+	if !cr.match(&v1.Event{Count: 3}, nil) {
+		t.Fatal("expected event with Count equal to MinCount to match")
+	}
+}
+
+func TestCompiledRuleMatchLabelSelector(t *testing.T) {
+	cr := compileTestRule(t, auditv1alpha1.AuditRuleSelector{
+		LabelSelector: &meta_v1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+	})
+
+	event := &v1.Event{}
+	if !cr.match(event, labels.Set{"app": "web"}) {
+		t.Fatal("expected event whose objectLabels satisfy the label selector to match")
+	}
+	if cr.match(event, labels.Set{"app": "db"}) {
+		t.Fatal("expected event whose objectLabels don't satisfy the label selector not to match")
+	}
+}
+
+func TestCompiledRuleMatchRequiresEveryField(t *testing.T) {
+	cr := compileTestRule(t, auditv1alpha1.AuditRuleSelector{
+		InvolvedObjectKind: "Pod",
+		Namespaces:         []string{"prod"},
+		Type:               v1.EventTypeWarning,
+	})
+
+	allMatch := &v1.Event{
+		InvolvedObject: v1.ObjectReference{Kind: "Pod"},
+		ObjectMeta:     meta_v1.ObjectMeta{Namespace: "prod"},
+		Type:           v1.EventTypeWarning,
+	}
+	if !cr.match(allMatch, nil) {
+		t.Fatal("expected an event satisfying every selector field to match")
+	}
+
+	oneFieldOff := &v1.Event{
+		InvolvedObject: v1.ObjectReference{Kind: "Pod"},
+		ObjectMeta:     meta_v1.ObjectMeta{Namespace: "prod"},
+		Type:           v1.EventTypeNormal,
+	}
+	if cr.match(oneFieldOff, nil) {
+		t.Fatal("expected an event failing even one selector field not to match")
+	}
+}
@@ -0,0 +1,66 @@
+package dispatch
+
+import (
+	"sync"
+	"time"
+)
+
+// defaultBreakerResetAfter is how long a tripped breaker stays open before
+// letting a probe request through.
+const defaultBreakerResetAfter = 30 * time.Second
+
+// breaker is a simple consecutive-failure circuit breaker. It trips after
+// failureThreshold consecutive failures and resets itself after resetAfter
+// has elapsed, so a single failing sink cannot be hammered forever nor
+// block the shared controller queue.
+type breaker struct {
+	mu               sync.Mutex
+	failureThreshold int
+	resetAfter       time.Duration
+
+	consecutiveFailures int
+	trippedAt           time.Time
+}
+
+func newBreaker(failureThreshold int, resetAfter time.Duration) *breaker {
+	return &breaker{
+		failureThreshold: failureThreshold,
+		resetAfter:       resetAfter,
+	}
+}
+
+// allow reports whether a request should be let through right now.
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.consecutiveFailures < b.failureThreshold {
+		return true
+	}
+
+	if time.Since(b.trippedAt) >= b.resetAfter {
+		// Half-open: let a single probe request through, and hold any
+		// others back for another resetAfter unless the probe's outcome
+		// is recorded first.
+		b.trippedAt = time.Now()
+		return true
+	}
+
+	return false
+}
+
+func (b *breaker) recordSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.consecutiveFailures = 0
+}
+
+func (b *breaker) recordFailure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.consecutiveFailures++
+	if b.consecutiveFailures >= b.failureThreshold {
+		b.trippedAt = time.Now()
+	}
+}
@@ -0,0 +1,55 @@
+package dispatch
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBreakerAllowsWhenClosed(t *testing.T) {
+	b := newBreaker(3, time.Minute)
+
+	if !b.allow() {
+		t.Fatal("expected a fresh breaker to allow requests through")
+	}
+}
+
+func TestBreakerTripsAfterConsecutiveFailures(t *testing.T) {
+	b := newBreaker(3, time.Minute)
+
+	b.recordFailure()
+	b.recordFailure()
+	if !b.allow() {
+		t.Fatal("expected breaker to still allow requests below failureThreshold")
+	}
+
+	b.recordFailure()
+	if b.allow() {
+		t.Fatal("expected breaker to trip once failureThreshold consecutive failures are recorded")
+	}
+}
+
+func TestBreakerStaysOpenUntilResetAfterElapses(t *testing.T) {
+	b := newBreaker(1, time.Minute)
+	b.recordFailure()
+
+	if b.allow() {
+		t.Fatal("expected breaker to stay open immediately after tripping")
+	}
+
+	b.trippedAt = b.trippedAt.Add(-time.Minute)
+	if !b.allow() {
+		t.Fatal("expected breaker to allow a probe request once resetAfter has elapsed")
+	}
+}
+
+func TestBreakerRecordSuccessResetsFailures(t *testing.T) {
+	b := newBreaker(2, time.Minute)
+
+	b.recordFailure()
+	b.recordSuccess()
+	b.recordFailure()
+
+	if !b.allow() {
+		t.Fatal("expected a success to reset consecutiveFailures, so one more failure should not trip the breaker")
+	}
+}
@@ -0,0 +1,36 @@
+package dispatch
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// Override carries per-dispatch routing overrides sourced from the
+// AuditRule SinkRef that matched an event, letting a single registered
+// sink be redirected without reconfiguring it globally. A zero-value
+// Override changes nothing, so a sink should fall back to its own
+// configured default for any field left empty.
+type Override struct {
+	// Channel overrides the Slack channel a SlackSink posts to.
+	Channel string
+	// URL overrides the endpoint a WebhookSink POSTs to.
+	URL string
+}
+
+// Sink dispatches a Kubernetes event to a single external destination,
+// e.g. a Slack channel or a webhook endpoint.
+type Sink interface {
+	// Name identifies the sink, as referenced from an AuditRule's Spec.Sinks.
+	Name() string
+
+	// Send delivers event to the sink, applying override where the sink
+	// supports per-dispatch routing.
+	Send(ctx context.Context, event *v1.Event, override Override) error
+
+	// Healthy reports whether the sink can currently accept traffic. It is
+	// separate from the circuit breaker Registry keeps for each sink.
+	// Registry.Dispatch does not call Send on a sink that is not healthy;
+	// that target's Result carries an error instead.
+	Healthy() bool
+}
@@ -0,0 +1,159 @@
+package dispatch
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	rate "golang.org/x/time/rate"
+	v1 "k8s.io/api/core/v1"
+)
+
+// defaultFailureThreshold is how many consecutive failures trip a sink's
+// breaker; defaultBreakerResetAfter bounds how long it then stays open
+// before allowing a probe request through.
+const (
+	defaultFailureThreshold = 5
+)
+
+// RateLimit configures the token-bucket limiter a registered sink is sent
+// through.
+type RateLimit struct {
+	// QPS is the steady-state rate of events per second allowed through to
+	// the sink. Zero means unlimited.
+	QPS float64
+	// Burst is the maximum number of events allowed in a single burst.
+	Burst int
+}
+
+type registeredSink struct {
+	sink    Sink
+	limiter *rate.Limiter
+	breaker *breaker
+}
+
+// Registry is a collection of named Sinks, each isolated behind its own
+// rate limiter and circuit breaker so that a single failing or
+// slow-to-respond sink cannot backpressure the others or the shared
+// controller queue.
+type Registry struct {
+	mu    sync.RWMutex
+	sinks map[string]*registeredSink
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{sinks: make(map[string]*registeredSink)}
+}
+
+// Register adds sink to the registry, rate limited according to limit.
+// A zero-value RateLimit leaves the sink unlimited.
+func (r *Registry) Register(sink Sink, limit RateLimit) {
+	var limiter *rate.Limiter
+	if limit.QPS > 0 {
+		limiter = rate.NewLimiter(rate.Limit(limit.QPS), limit.Burst)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sinks[sink.Name()] = &registeredSink{
+		sink:    sink,
+		limiter: limiter,
+		breaker: newBreaker(defaultFailureThreshold, defaultBreakerResetAfter),
+	}
+}
+
+// Get returns the sink registered under name, if any.
+func (r *Registry) Get(name string) (Sink, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	rs, ok := r.sinks[name]
+	if !ok {
+		return nil, false
+	}
+	return rs.sink, true
+}
+
+// Names returns the names of every registered sink.
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.sinks))
+	for name := range r.sinks {
+		names = append(names, name)
+	}
+	return names
+}
+
+// Result is the outcome of dispatching an event to a single named sink.
+type Result struct {
+	Sink string
+	Err  error
+}
+
+// Target identifies a registered sink to dispatch to, along with any
+// per-dispatch routing overrides sourced from the AuditRule SinkRef that
+// matched the event.
+type Target struct {
+	Name     string
+	Override Override
+}
+
+// matchedTarget pairs a registeredSink with the Override to dispatch it
+// with.
+type matchedTarget struct {
+	rs       *registeredSink
+	override Override
+}
+
+// Dispatch sends event to every target concurrently, honoring each sink's
+// rate limiter and circuit breaker, and returns once every send has
+// completed (or been skipped).
+func (r *Registry) Dispatch(ctx context.Context, targets []Target, event *v1.Event) []Result {
+	r.mu.RLock()
+	matched := make([]matchedTarget, 0, len(targets))
+	for _, target := range targets {
+		if rs, ok := r.sinks[target.Name]; ok {
+			matched = append(matched, matchedTarget{rs: rs, override: target.Override})
+		}
+	}
+	r.mu.RUnlock()
+
+	results := make([]Result, len(matched))
+	var wg sync.WaitGroup
+	for i, m := range matched {
+		wg.Add(1)
+		go func(i int, m matchedTarget) {
+			defer wg.Done()
+			results[i] = Result{Sink: m.rs.sink.Name(), Err: m.rs.send(ctx, event, m.override)}
+		}(i, m)
+	}
+	wg.Wait()
+
+	return results
+}
+
+func (rs *registeredSink) send(ctx context.Context, event *v1.Event, override Override) error {
+	if !rs.sink.Healthy() {
+		return fmt.Errorf("sink %q: unhealthy, skipping send", rs.sink.Name())
+	}
+
+	if !rs.breaker.allow() {
+		return fmt.Errorf("sink %q: circuit open, skipping send", rs.sink.Name())
+	}
+
+	if rs.limiter != nil {
+		if err := rs.limiter.Wait(ctx); err != nil {
+			return fmt.Errorf("sink %q: rate limiter: %s", rs.sink.Name(), err)
+		}
+	}
+
+	err := rs.sink.Send(ctx, event, override)
+	if err != nil {
+		rs.breaker.recordFailure()
+		return err
+	}
+
+	rs.breaker.recordSuccess()
+	return nil
+}
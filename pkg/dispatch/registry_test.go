@@ -0,0 +1,122 @@
+package dispatch
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// fakeSink records the Override of every send it receives and lets tests
+// force a send to fail a fixed number of times.
+type fakeSink struct {
+	name string
+
+	mu        sync.Mutex
+	failNext  int
+	sends     []Override
+	unhealthy bool
+}
+
+func (f *fakeSink) Name() string { return f.name }
+
+func (f *fakeSink) Send(ctx context.Context, event *v1.Event, override Override) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.sends = append(f.sends, override)
+	if f.failNext > 0 {
+		f.failNext--
+		return fmt.Errorf("fake sink %q: forced failure", f.name)
+	}
+	return nil
+}
+
+func (f *fakeSink) Healthy() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return !f.unhealthy
+}
+
+func (f *fakeSink) sendCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.sends)
+}
+
+func TestRegistryDispatchFansOutToEveryTarget(t *testing.T) {
+	r := NewRegistry()
+	a := &fakeSink{name: "a"}
+	b := &fakeSink{name: "b"}
+	r.Register(a, RateLimit{})
+	r.Register(b, RateLimit{})
+
+	event := &v1.Event{}
+	results := r.Dispatch(context.Background(), []Target{{Name: "a"}, {Name: "b"}}, event)
+
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	for _, result := range results {
+		if result.Err != nil {
+			t.Fatalf("unexpected error dispatching to %q: %s", result.Sink, result.Err)
+		}
+	}
+	if a.sendCount() != 1 || b.sendCount() != 1 {
+		t.Fatalf("expected both sinks to receive exactly one send, got a=%d b=%d", a.sendCount(), b.sendCount())
+	}
+}
+
+func TestRegistryDispatchOneFailureDoesNotBlockOthers(t *testing.T) {
+	r := NewRegistry()
+	failing := &fakeSink{name: "failing", failNext: 1}
+	healthy := &fakeSink{name: "healthy"}
+	r.Register(failing, RateLimit{})
+	r.Register(healthy, RateLimit{})
+
+	event := &v1.Event{}
+	results := r.Dispatch(context.Background(), []Target{{Name: "failing"}, {Name: "healthy"}}, event)
+
+	byName := make(map[string]Result, len(results))
+	for _, result := range results {
+		byName[result.Sink] = result
+	}
+
+	if byName["failing"].Err == nil {
+		t.Fatal("expected the failing sink's result to carry an error")
+	}
+	if byName["healthy"].Err != nil {
+		t.Fatalf("expected the healthy sink to succeed, got %s", byName["healthy"].Err)
+	}
+}
+
+func TestRegistryDispatchSkipsUnhealthySink(t *testing.T) {
+	r := NewRegistry()
+	sink := &fakeSink{name: "sink", unhealthy: true}
+	r.Register(sink, RateLimit{})
+
+	event := &v1.Event{}
+	results := r.Dispatch(context.Background(), []Target{{Name: "sink"}}, event)
+
+	if len(results) != 1 || results[0].Err == nil {
+		t.Fatal("expected dispatch to an unhealthy sink to return an error result")
+	}
+	if sink.sendCount() != 0 {
+		t.Fatal("expected an unhealthy sink to never have Send called")
+	}
+}
+
+func TestRegistryDispatchPassesOverrideThrough(t *testing.T) {
+	r := NewRegistry()
+	sink := &fakeSink{name: "sink"}
+	r.Register(sink, RateLimit{})
+
+	override := Override{Channel: "#overridden"}
+	r.Dispatch(context.Background(), []Target{{Name: "sink", Override: override}}, &v1.Event{})
+
+	if len(sink.sends) != 1 || sink.sends[0] != override {
+		t.Fatalf("expected the sink to receive the override, got %+v", sink.sends)
+	}
+}
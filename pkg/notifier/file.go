@@ -0,0 +1,89 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	dispatch "github.com/owainlewis/convoy/pkg/dispatch"
+)
+
+// fileRecord is a single line written to a FileSink.
+type fileRecord struct {
+	Timestamp time.Time `json:"timestamp"`
+	Subject   string    `json:"subject"`
+	Body      string    `json:"body"`
+}
+
+// FileSink appends a JSONL record for every event to a writer, e.g. stdout
+// or a log file on disk.
+type FileSink struct {
+	name     string
+	mu       sync.Mutex
+	out      io.Writer
+	closer   io.Closer
+	template *MessageTemplate
+}
+
+// NewFileSink creates a Sink named name that writes JSONL records to out.
+func NewFileSink(name string, out io.Writer, template *MessageTemplate) *FileSink {
+	return &FileSink{name: name, out: out, template: template}
+}
+
+// NewFileSinkFromPath creates a FileSink that appends to the file at path,
+// creating it if necessary.
+func NewFileSinkFromPath(name string, path string, template *MessageTemplate) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	sink := NewFileSink(name, f, template)
+	sink.closer = f
+	return sink, nil
+}
+
+// Name implements dispatch.Sink.
+func (f *FileSink) Name() string {
+	return f.name
+}
+
+// Send implements dispatch.Sink. FileSink has no per-dispatch routing to
+// override, so override is ignored.
+func (f *FileSink) Send(ctx context.Context, event *v1.Event, override dispatch.Override) error {
+	subject, body, err := f.template.Render(event)
+	if err != nil {
+		return err
+	}
+
+	record, err := json.Marshal(fileRecord{
+		Timestamp: time.Now(),
+		Subject:   subject,
+		Body:      body,
+	})
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	_, err = f.out.Write(append(record, '\n'))
+	return err
+}
+
+// Healthy implements dispatch.Sink.
+func (f *FileSink) Healthy() bool {
+	return true
+}
+
+// Close closes the underlying file, if one was opened via NewFileSinkFromPath.
+func (f *FileSink) Close() error {
+	if f.closer == nil {
+		return nil
+	}
+	return f.closer.Close()
+}
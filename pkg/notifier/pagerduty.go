@@ -0,0 +1,109 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	dispatch "github.com/owainlewis/convoy/pkg/dispatch"
+)
+
+// pagerDutyEventsURL is the PagerDuty Events API v2 endpoint.
+const pagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+type pagerDutyEvent struct {
+	RoutingKey  string         `json:"routing_key"`
+	EventAction string         `json:"event_action"`
+	Payload     pagerDutyAlert `json:"payload"`
+}
+
+type pagerDutyAlert struct {
+	Summary       string            `json:"summary"`
+	Source        string            `json:"source"`
+	Severity      string            `json:"severity"`
+	CustomDetails map[string]string `json:"custom_details,omitempty"`
+}
+
+// PagerDutySink triggers a PagerDuty alert via the Events API v2.
+type PagerDutySink struct {
+	name       string
+	routingKey string
+	client     *http.Client
+	template   *MessageTemplate
+}
+
+// NewPagerDutySink creates a Sink named name that triggers alerts using routingKey.
+func NewPagerDutySink(name string, routingKey string, template *MessageTemplate) *PagerDutySink {
+	return &PagerDutySink{
+		name:       name,
+		routingKey: routingKey,
+		client:     &http.Client{Timeout: 10 * time.Second},
+		template:   template,
+	}
+}
+
+// Name implements dispatch.Sink.
+func (p *PagerDutySink) Name() string {
+	return p.name
+}
+
+// Send implements dispatch.Sink. PagerDuty has no per-dispatch routing to
+// override, so override is ignored.
+func (p *PagerDutySink) Send(ctx context.Context, event *v1.Event, override dispatch.Override) error {
+	subject, body, err := p.template.Render(event)
+	if err != nil {
+		return err
+	}
+
+	payload := pagerDutyEvent{
+		RoutingKey:  p.routingKey,
+		EventAction: "trigger",
+		Payload: pagerDutyAlert{
+			Summary:       subject,
+			Source:        fmt.Sprintf("%s/%s", event.InvolvedObject.Namespace, event.InvolvedObject.Name),
+			Severity:      pagerDutySeverity(event.Type),
+			CustomDetails: map[string]string{"body": body},
+		},
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, pagerDutyEventsURL, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("pagerduty %q: unexpected status %s", p.name, resp.Status)
+	}
+
+	return nil
+}
+
+// Healthy implements dispatch.Sink.
+func (p *PagerDutySink) Healthy() bool {
+	return true
+}
+
+// pagerDutySeverity maps a Kubernetes event type to a PagerDuty severity.
+func pagerDutySeverity(eventType string) string {
+	if eventType == v1.EventTypeWarning {
+		return "warning"
+	}
+	return "info"
+}
@@ -0,0 +1,97 @@
+package notifier
+
+import (
+	"fmt"
+	"net/smtp"
+	"os"
+
+	slack "github.com/nlopes/slack"
+	config "github.com/owainlewis/convoy/pkg/config"
+	dispatch "github.com/owainlewis/convoy/pkg/dispatch"
+)
+
+// RegistryFromConfig builds a dispatch.Registry with one Sink per entry in
+// cfg.Sinks.
+func RegistryFromConfig(cfg *config.Config) (*dispatch.Registry, error) {
+	registry := dispatch.NewRegistry()
+
+	for _, sinkCfg := range cfg.Sinks {
+		sink, err := sinkFromConfig(sinkCfg)
+		if err != nil {
+			return nil, fmt.Errorf("sink %q: %s", sinkCfg.Name, err)
+		}
+
+		registry.Register(sink, dispatch.RateLimit{
+			QPS:   sinkCfg.RateLimit.QPS,
+			Burst: sinkCfg.RateLimit.Burst,
+		})
+	}
+
+	return registry, nil
+}
+
+func sinkFromConfig(cfg config.SinkConfig) (dispatch.Sink, error) {
+	subject := cfg.Subject
+	if subject == "" {
+		subject = DefaultSubjectTemplate
+	}
+	body := cfg.Body
+	if body == "" {
+		body = DefaultBodyTemplate
+	}
+
+	template, err := NewMessageTemplate(subject, body)
+	if err != nil {
+		return nil, fmt.Errorf("invalid message template: %s", err)
+	}
+
+	switch cfg.Type {
+	case "slack":
+		if cfg.Slack == nil {
+			return nil, fmt.Errorf("type slack requires a slack: block")
+		}
+		client := slack.New(cfg.Slack.Token)
+		return NewSlackSink(cfg.Name, client, cfg.Slack.Channel, template), nil
+
+	case "webhook":
+		if cfg.Webhook == nil {
+			return nil, fmt.Errorf("type webhook requires a webhook: block")
+		}
+		return NewWebhookSink(cfg.Name, cfg.Webhook.URL, template), nil
+
+	case "pagerduty":
+		if cfg.PagerDuty == nil {
+			return nil, fmt.Errorf("type pagerduty requires a pagerDuty: block")
+		}
+		return NewPagerDutySink(cfg.Name, cfg.PagerDuty.RoutingKey, template), nil
+
+	case "teams":
+		if cfg.Teams == nil {
+			return nil, fmt.Errorf("type teams requires a teams: block")
+		}
+		return NewTeamsSink(cfg.Name, cfg.Teams.WebhookURL, template), nil
+
+	case "smtp":
+		if cfg.SMTP == nil {
+			return nil, fmt.Errorf("type smtp requires an smtp: block")
+		}
+		var auth smtp.Auth
+		if cfg.SMTP.Username != "" {
+			auth = smtp.PlainAuth("", cfg.SMTP.Username, cfg.SMTP.Password, cfg.SMTP.Host)
+		}
+		return NewSMTPSink(cfg.Name, cfg.SMTP.Host, cfg.SMTP.Port, auth, cfg.SMTP.From, cfg.SMTP.To, template), nil
+
+	case "file":
+		path := ""
+		if cfg.File != nil {
+			path = cfg.File.Path
+		}
+		if path == "" {
+			return NewFileSink(cfg.Name, os.Stdout, template), nil
+		}
+		return NewFileSinkFromPath(cfg.Name, path, template)
+
+	default:
+		return nil, fmt.Errorf("unknown sink type %q", cfg.Type)
+	}
+}
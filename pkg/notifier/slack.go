@@ -0,0 +1,56 @@
+package notifier
+
+import (
+	"context"
+
+	slack "github.com/nlopes/slack"
+	v1 "k8s.io/api/core/v1"
+
+	dispatch "github.com/owainlewis/convoy/pkg/dispatch"
+)
+
+// SlackSink dispatches events to a Slack channel.
+type SlackSink struct {
+	name     string
+	client   *slack.Client
+	channel  string
+	template *MessageTemplate
+}
+
+// NewSlackSink creates a Sink named name that posts to channel using client.
+func NewSlackSink(name string, client *slack.Client, channel string, template *MessageTemplate) *SlackSink {
+	return &SlackSink{
+		name:     name,
+		client:   client,
+		channel:  channel,
+		template: template,
+	}
+}
+
+// Name implements dispatch.Sink.
+func (s *SlackSink) Name() string {
+	return s.name
+}
+
+// Send implements dispatch.Sink. When override.Channel is set, it is used
+// in place of the sink's configured channel for this dispatch only.
+func (s *SlackSink) Send(ctx context.Context, event *v1.Event, override dispatch.Override) error {
+	_, body, err := s.template.Render(event)
+	if err != nil {
+		return err
+	}
+
+	channel := s.channel
+	if override.Channel != "" {
+		channel = override.Channel
+	}
+
+	_, _, err = s.client.PostMessage(channel, slack.MsgOptionText(body, false))
+	return err
+}
+
+// Healthy implements dispatch.Sink. The Slack client has no persistent
+// connection to probe, so it is always considered healthy.
+func (s *SlackSink) Healthy() bool {
+	return true
+}
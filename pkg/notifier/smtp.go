@@ -0,0 +1,73 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"net/smtp"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	dispatch "github.com/owainlewis/convoy/pkg/dispatch"
+)
+
+// SMTPSink emails a templated subject/body to a fixed set of recipients.
+type SMTPSink struct {
+	name     string
+	addr     string
+	auth     smtp.Auth
+	from     string
+	to       []string
+	template *MessageTemplate
+}
+
+// NewSMTPSink creates a Sink named name that emails From via the server at
+// host:port, authenticating with auth if non-nil.
+func NewSMTPSink(name string, host string, port int, auth smtp.Auth, from string, to []string, template *MessageTemplate) *SMTPSink {
+	return &SMTPSink{
+		name:     name,
+		addr:     fmt.Sprintf("%s:%d", host, port),
+		auth:     auth,
+		from:     from,
+		to:       to,
+		template: template,
+	}
+}
+
+// Name implements dispatch.Sink.
+func (s *SMTPSink) Name() string {
+	return s.name
+}
+
+// Send implements dispatch.Sink. SMTP has no per-dispatch routing to
+// override, so override is ignored.
+//
+// net/smtp has no context-aware API, so ctx is only honoured up front via
+// ctx.Err(); the send itself is not cancellable mid-flight.
+func (s *SMTPSink) Send(ctx context.Context, event *v1.Event, override dispatch.Override) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	subject, body, err := s.template.Render(event)
+	if err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("Subject: %s\r\n\r\n%s\r\n", sanitizeHeaderValue(subject), body)
+	return smtp.SendMail(s.addr, s.auth, s.from, s.to, []byte(message))
+}
+
+// Healthy implements dispatch.Sink.
+func (s *SMTPSink) Healthy() bool {
+	return true
+}
+
+// sanitizeHeaderValue strips CR and LF from v so it cannot be used to
+// inject additional headers (e.g. Bcc) into a raw SMTP message. v is
+// templated from event fields such as Reason, which are not trusted input.
+func sanitizeHeaderValue(v string) string {
+	v = strings.ReplaceAll(v, "\r", "")
+	v = strings.ReplaceAll(v, "\n", "")
+	return v
+}
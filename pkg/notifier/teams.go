@@ -0,0 +1,91 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	dispatch "github.com/owainlewis/convoy/pkg/dispatch"
+)
+
+// teamsCard is a minimal Microsoft Teams "MessageCard" payload.
+type teamsCard struct {
+	Type    string `json:"@type"`
+	Context string `json:"@context"`
+	Summary string `json:"summary"`
+	Title   string `json:"title"`
+	Text    string `json:"text"`
+}
+
+// TeamsSink posts a MessageCard to a Microsoft Teams incoming webhook.
+type TeamsSink struct {
+	name       string
+	webhookURL string
+	client     *http.Client
+	template   *MessageTemplate
+}
+
+// NewTeamsSink creates a Sink named name that posts to webhookURL.
+func NewTeamsSink(name string, webhookURL string, template *MessageTemplate) *TeamsSink {
+	return &TeamsSink{
+		name:       name,
+		webhookURL: webhookURL,
+		client:     &http.Client{Timeout: 10 * time.Second},
+		template:   template,
+	}
+}
+
+// Name implements dispatch.Sink.
+func (t *TeamsSink) Name() string {
+	return t.name
+}
+
+// Send implements dispatch.Sink. Teams has no per-dispatch routing to
+// override, so override is ignored.
+func (t *TeamsSink) Send(ctx context.Context, event *v1.Event, override dispatch.Override) error {
+	subject, body, err := t.template.Render(event)
+	if err != nil {
+		return err
+	}
+
+	card := teamsCard{
+		Type:    "MessageCard",
+		Context: "http://schema.org/extensions",
+		Summary: subject,
+		Title:   subject,
+		Text:    body,
+	}
+
+	data, err := json.Marshal(card)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.webhookURL, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("teams %q: unexpected status %s", t.name, resp.Status)
+	}
+
+	return nil
+}
+
+// Healthy implements dispatch.Sink.
+func (t *TeamsSink) Healthy() bool {
+	return true
+}
@@ -0,0 +1,54 @@
+package notifier
+
+import (
+	"bytes"
+	"text/template"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// DefaultSubjectTemplate is used by sinks that don't specify their own.
+	DefaultSubjectTemplate = "{{.Reason}}"
+
+	// DefaultBodyTemplate is used by sinks that don't specify their own.
+	DefaultBodyTemplate = "[{{.Type}}] {{.InvolvedObject.Kind}}/{{.InvolvedObject.Name}}: {{.Message}}"
+)
+
+// MessageTemplate renders a subject and body for an event from Go templates,
+// so operators control what a Slack post vs. a webhook payload looks like
+// without recompiling the controller.
+type MessageTemplate struct {
+	subject *template.Template
+	body    *template.Template
+}
+
+// NewMessageTemplate parses subject and body as Go templates, executed
+// against a *v1.Event.
+func NewMessageTemplate(subject, body string) (*MessageTemplate, error) {
+	subjectTmpl, err := template.New("subject").Parse(subject)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyTmpl, err := template.New("body").Parse(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MessageTemplate{subject: subjectTmpl, body: bodyTmpl}, nil
+}
+
+// Render executes the subject and body templates against event.
+func (t *MessageTemplate) Render(event *v1.Event) (subject string, body string, err error) {
+	var subjectBuf, bodyBuf bytes.Buffer
+
+	if err := t.subject.Execute(&subjectBuf, event); err != nil {
+		return "", "", err
+	}
+	if err := t.body.Execute(&bodyBuf, event); err != nil {
+		return "", "", err
+	}
+
+	return subjectBuf.String(), bodyBuf.String(), nil
+}
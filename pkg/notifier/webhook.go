@@ -0,0 +1,86 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	dispatch "github.com/owainlewis/convoy/pkg/dispatch"
+)
+
+// webhookPayload is the JSON envelope posted to a WebhookSink's URL.
+type webhookPayload struct {
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+// WebhookSink POSTs a templated JSON body to an arbitrary HTTP endpoint.
+type WebhookSink struct {
+	name     string
+	url      string
+	client   *http.Client
+	template *MessageTemplate
+}
+
+// NewWebhookSink creates a Sink named name that posts to url.
+func NewWebhookSink(name string, url string, template *MessageTemplate) *WebhookSink {
+	return &WebhookSink{
+		name:     name,
+		url:      url,
+		client:   &http.Client{Timeout: 10 * time.Second},
+		template: template,
+	}
+}
+
+// Name implements dispatch.Sink.
+func (w *WebhookSink) Name() string {
+	return w.name
+}
+
+// Send implements dispatch.Sink. When override.URL is set, it is used in
+// place of the sink's configured URL for this dispatch only.
+func (w *WebhookSink) Send(ctx context.Context, event *v1.Event, override dispatch.Override) error {
+	subject, body, err := w.template.Render(event)
+	if err != nil {
+		return err
+	}
+
+	url := w.url
+	if override.URL != "" {
+		url = override.URL
+	}
+
+	data, err := json.Marshal(webhookPayload{Subject: subject, Body: body})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook %q: unexpected status %s", w.name, resp.Status)
+	}
+
+	return nil
+}
+
+// Healthy implements dispatch.Sink. Webhooks have no persistent connection
+// to probe, so they are always considered healthy between sends.
+func (w *WebhookSink) Healthy() bool {
+	return true
+}
@@ -0,0 +1,50 @@
+package predicate
+
+import v1 "k8s.io/api/core/v1"
+
+// And matches when every one of predicates matches. An empty And matches
+// everything.
+func And(predicates ...Predicate) Predicate {
+	return andPredicate(predicates)
+}
+
+type andPredicate []Predicate
+
+func (a andPredicate) Match(event *v1.Event) bool {
+	for _, p := range a {
+		if !p.Match(event) {
+			return false
+		}
+	}
+	return true
+}
+
+// Or matches when at least one of predicates matches. An empty Or matches
+// nothing.
+func Or(predicates ...Predicate) Predicate {
+	return orPredicate(predicates)
+}
+
+type orPredicate []Predicate
+
+func (o orPredicate) Match(event *v1.Event) bool {
+	for _, p := range o {
+		if p.Match(event) {
+			return true
+		}
+	}
+	return false
+}
+
+// Not inverts p.
+func Not(p Predicate) Predicate {
+	return notPredicate{p}
+}
+
+type notPredicate struct {
+	p Predicate
+}
+
+func (n notPredicate) Match(event *v1.Event) bool {
+	return !n.p.Match(event)
+}
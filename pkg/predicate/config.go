@@ -0,0 +1,65 @@
+package predicate
+
+import (
+	"fmt"
+	"time"
+
+	config "github.com/owainlewis/convoy/pkg/config"
+)
+
+// ChainFromConfig builds the predicate chain described by cfgs, so the set
+// of predicates evaluated before AuditRule matching and dedup is settable
+// from the controller's config file without recompiling. Each entry in
+// cfgs becomes one ANDed predicate; the chain as a whole is unordered, so
+// an event must satisfy every configured entry to pass.
+func ChainFromConfig(cfgs []config.PredicateConfig) ([]Predicate, error) {
+	chain := make([]Predicate, 0, len(cfgs))
+	for i, cfg := range cfgs {
+		p, err := predicateFromConfig(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("predicates[%d]: %s", i, err)
+		}
+		chain = append(chain, p)
+	}
+	return chain, nil
+}
+
+// predicateFromConfig ANDs together the predicates implied by every set
+// field of cfg.
+func predicateFromConfig(cfg config.PredicateConfig) (Predicate, error) {
+	var parts []Predicate
+
+	if cfg.InvolvedObjectKind != "" {
+		parts = append(parts, InvolvedObjectKind(cfg.InvolvedObjectKind))
+	}
+
+	if len(cfg.Namespaces) > 0 {
+		parts = append(parts, NewNamespaceSet(cfg.Namespaces...))
+	}
+
+	if cfg.Type != "" {
+		parts = append(parts, EventType(cfg.Type))
+	}
+
+	if cfg.ReasonRegex != "" {
+		re, err := NewReasonRegex(cfg.ReasonRegex)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, re)
+	}
+
+	if cfg.MinCount > 0 {
+		parts = append(parts, MinCount(cfg.MinCount))
+	}
+
+	if cfg.AgeWindow != "" {
+		window, err := time.ParseDuration(cfg.AgeWindow)
+		if err != nil {
+			return nil, fmt.Errorf("ageWindow: %s", err)
+		}
+		parts = append(parts, NewAgeWindow(window))
+	}
+
+	return And(parts...), nil
+}
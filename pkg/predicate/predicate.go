@@ -0,0 +1,21 @@
+// Package predicate provides small, composable filters over *v1.Event,
+// used by ConvoyController to decide whether an event is even a candidate
+// for dispatch before it reaches AuditRule matching and dedup.
+package predicate
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// Predicate reports whether event should be allowed through.
+type Predicate interface {
+	Match(event *v1.Event) bool
+}
+
+// Func adapts a plain function to the Predicate interface.
+type Func func(event *v1.Event) bool
+
+// Match implements Predicate.
+func (f Func) Match(event *v1.Event) bool {
+	return f(event)
+}
@@ -0,0 +1,89 @@
+package predicate
+
+import (
+	"regexp"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// InvolvedObjectKind matches events whose InvolvedObject.Kind equals Kind.
+type InvolvedObjectKind string
+
+// Match implements Predicate.
+func (k InvolvedObjectKind) Match(event *v1.Event) bool {
+	return event.InvolvedObject.Kind == string(k)
+}
+
+// ReasonRegex matches events whose Reason satisfies a regular expression.
+type ReasonRegex struct {
+	re *regexp.Regexp
+}
+
+// NewReasonRegex compiles pattern into a ReasonRegex predicate.
+func NewReasonRegex(pattern string) (*ReasonRegex, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return &ReasonRegex{re: re}, nil
+}
+
+// Match implements Predicate.
+func (r *ReasonRegex) Match(event *v1.Event) bool {
+	return r.re.MatchString(event.Reason)
+}
+
+// NamespaceSet matches events whose Namespace is one of a fixed set.
+type NamespaceSet map[string]bool
+
+// NewNamespaceSet builds a NamespaceSet from the given namespaces.
+func NewNamespaceSet(namespaces ...string) NamespaceSet {
+	set := make(NamespaceSet, len(namespaces))
+	for _, ns := range namespaces {
+		set[ns] = true
+	}
+	return set
+}
+
+// Match implements Predicate.
+func (s NamespaceSet) Match(event *v1.Event) bool {
+	return s[event.Namespace]
+}
+
+// EventType matches events of a specific Type, e.g. v1.EventTypeWarning.
+type EventType string
+
+// Match implements Predicate.
+func (t EventType) Match(event *v1.Event) bool {
+	return event.Type == string(t)
+}
+
+// MinCount matches events whose Count has reached at least n.
+type MinCount int32
+
+// Match implements Predicate.
+func (n MinCount) Match(event *v1.Event) bool {
+	return event.Count >= int32(n)
+}
+
+// AgeWindow matches events last observed within window of now.
+type AgeWindow struct {
+	window time.Duration
+	now    func() time.Time
+}
+
+// NewAgeWindow builds an AgeWindow predicate that matches events last
+// observed no more than window ago.
+func NewAgeWindow(window time.Duration) *AgeWindow {
+	return &AgeWindow{window: window, now: time.Now}
+}
+
+// Match implements Predicate.
+func (a *AgeWindow) Match(event *v1.Event) bool {
+	observed := event.LastTimestamp
+	if observed.IsZero() {
+		observed = event.CreationTimestamp
+	}
+	return a.now().Sub(observed.Time) <= a.window
+}